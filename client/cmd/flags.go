@@ -0,0 +1,26 @@
+package cmd
+
+// Flags shared between the legacy root command (back-compat flat-flag
+// mode) and the synthesize/resume subcommands. Declared once here so
+// registerSynthesizeFlags can bind the same variables to more than one
+// *pflag.FlagSet.
+var (
+	workersFlag   int
+	recursiveFlag bool
+	verboseFlag   bool
+	serverFlag    string
+	timeoutFlag   int
+	scanFlag      string // deprecated: legacy -scan, superseded by a positional path arg
+
+	voiceFlag               string
+	splitFlag               string
+	formatFlag              string
+	resumeFlag              bool
+	forceFlag               bool
+	cleanStateFlag          bool
+	rpsFlag                 float64
+	simulateFailureRateFlag float64
+	transportFlag           string
+	grpcServerFlag          string
+	stdoutFlag              bool
+)
@@ -0,0 +1,21 @@
+package cmd
+
+import "github.com/spf13/cobra"
+
+// resumeCmd is synthesize with -resume forced on: it uses the job
+// manifest (.mayaspeechify-state.json) to skip files already completed
+// by a prior run.
+var resumeCmd = &cobra.Command{
+	Use:   "resume <path>",
+	Short: "Synthesize, skipping files already completed in the job manifest",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		resumeFlag = true
+		return synthesizePath(args[0])
+	},
+}
+
+func init() {
+	registerSynthesizeFlags(resumeCmd.Flags())
+	rootCmd.AddCommand(resumeCmd)
+}
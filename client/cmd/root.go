@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// rootCmd is the base command. With no subcommand and a -scan path, it
+// reproduces the original flat-flag CLI's behavior (mayaspeechify -scan
+// <path> [options]) for scripts that predate the scan/synthesize/voices/
+// resume/serve-ui subcommands.
+var rootCmd = &cobra.Command{
+	Use:   "mayaspeechify",
+	Short: "MayaSpeechify - Text-to-Speech Client",
+	Long: `MayaSpeechify scans a directory for .txt files and synthesizes each
+into an MP3 (or chaptered M4B) using a Maya1-compatible TTS server.
+
+Subcommands:
+  scan        list the .txt files that would be processed, without synthesizing
+  synthesize  scan and synthesize (the original default behavior)
+  resume      synthesize, skipping files already completed in the job manifest
+  voices      list or test named voice presets from voices.json
+  serve-ui    serve a local web UI (not yet implemented)
+
+Running with no subcommand and a -scan path reproduces the original
+flat-flag CLI; prefer 'mayaspeechify synthesize <path>' going forward.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if scanFlag == "" {
+			return cmd.Help()
+		}
+		return synthesizePath(scanFlag)
+	},
+}
+
+// Execute runs the root command, exiting non-zero on failure.
+func Execute() {
+	os.Args = normalizeLegacyFlags(os.Args, collectLongFlagNames(rootCmd))
+
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+// collectLongFlagNames walks cmd and every subcommand it has registered,
+// returning the set of all long flag names known to any of them. Deriving
+// this from the actual *pflag.FlagSet definitions - rather than a
+// hand-maintained list - means a flag added to registerSynthesizeFlags or
+// a command's own init() is automatically eligible for legacy single-dash
+// rewriting, with no separate list to keep in sync.
+func collectLongFlagNames(cmd *cobra.Command) map[string]bool {
+	names := map[string]bool{}
+	var visit func(c *cobra.Command)
+	visit = func(c *cobra.Command) {
+		c.Flags().VisitAll(func(f *pflag.Flag) { names[f.Name] = true })
+		c.PersistentFlags().VisitAll(func(f *pflag.Flag) { names[f.Name] = true })
+		for _, sub := range c.Commands() {
+			visit(sub)
+		}
+	}
+	visit(cmd)
+	return names
+}
+
+// legacyLongFlagRE extracts the candidate flag name from a single-dash
+// token, e.g. "-workers" or "-workers=4" -> "workers".
+var legacyLongFlagRE = regexp.MustCompile(`^-([A-Za-z][A-Za-z0-9-]*)(=.*)?$`)
+
+// normalizeLegacyFlags rewrites single-dash long flags into pflag's
+// double-dash form, so scripts built around the original `flag`-based CLI
+// keep working unchanged. Tokens whose name isn't in longFlagNames - pflag
+// shorthands, attached shorthand values, combined shorthand clusters - are
+// passed through untouched.
+func normalizeLegacyFlags(args []string, longFlagNames map[string]bool) []string {
+	out := make([]string, len(args))
+	for i, arg := range args {
+		out[i] = arg
+		if m := legacyLongFlagRE.FindStringSubmatch(arg); m != nil && longFlagNames[m[1]] {
+			out[i] = "-" + arg
+		}
+	}
+	return out
+}
+
+func init() {
+	rootCmd.SilenceUsage = true
+	rootCmd.SilenceErrors = true
+
+	pf := rootCmd.PersistentFlags()
+	pf.IntVarP(&workersFlag, "workers", "w", 0, "Number of parallel workers (0 = config.json default)")
+	pf.BoolVarP(&recursiveFlag, "recursive", "r", false, "Search subdirectories recursively")
+	pf.BoolVarP(&verboseFlag, "verbose", "v", false, "Enable detailed logging")
+	pf.StringVar(&serverFlag, "server", "", "Maya1 API server URL (default: config.json server_url)")
+	pf.IntVar(&timeoutFlag, "timeout", 0, "HTTP request timeout in seconds (0 = config.json default)")
+
+	rootCmd.Flags().StringVar(&scanFlag, "scan", "", "Root directory to scan (deprecated: use 'mayaspeechify synthesize <path>')")
+	registerSynthesizeFlags(rootCmd.Flags())
+}
@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/KennethJefferson/MayaSpeechifier/client/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// scanCmd is a dry run: it lists the .txt files a synthesize/resume run
+// would process, without contacting the server.
+var scanCmd = &cobra.Command{
+	Use:   "scan <path>",
+	Short: "List the .txt files that would be processed, without synthesizing them",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runScan(args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(scanCmd)
+}
+
+func runScan(path string) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return fmt.Errorf("scan path does not exist: %s", path)
+	}
+
+	files, err := app.DiscoverFiles(path, recursiveFlag)
+	if err != nil {
+		return fmt.Errorf("failed to scan files: %w", err)
+	}
+
+	if len(files) == 0 {
+		fmt.Println("No .txt files found")
+		return nil
+	}
+
+	fmt.Printf("Found %d text file(s):\n", len(files))
+	for _, f := range files {
+		fmt.Println(" ", f)
+	}
+
+	return nil
+}
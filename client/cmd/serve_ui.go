@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+// serveUICmd is a placeholder: the web UI it will serve is tracked as a
+// separate piece of work and doesn't exist in this tree yet.
+var serveUICmd = &cobra.Command{
+	Use:   "serve-ui",
+	Short: "Serve a local web UI for browsing and triggering synthesis (not yet implemented)",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return fmt.Errorf("serve-ui is not implemented yet")
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(serveUICmd)
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/KennethJefferson/MayaSpeechifier/client/internal/app"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var synthesizeCmd = &cobra.Command{
+	Use:   "synthesize <path>",
+	Short: "Scan a directory and synthesize its .txt files to audio",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return synthesizePath(args[0])
+	},
+}
+
+func init() {
+	registerSynthesizeFlags(synthesizeCmd.Flags())
+	rootCmd.AddCommand(synthesizeCmd)
+}
+
+// registerSynthesizeFlags defines the flags shared by the legacy root
+// command and the synthesize/resume subcommands on fs, all bound to the
+// same package-level variables declared in flags.go.
+func registerSynthesizeFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&voiceFlag, "voice", "", "Named voice preset from voices.json (e.g. narrator, child, villain)")
+	fs.StringVar(&splitFlag, "split", "none", "How to split long files before synthesis: paragraph|chapter|chars:N|none")
+	fs.StringVar(&formatFlag, "format", "mp3", "Output audio format: mp3|m4b (m4b requires ffmpeg on PATH)")
+	fs.BoolVar(&resumeFlag, "resume", false, "Skip files already completed in .mayaspeechify-state.json")
+	fs.BoolVar(&forceFlag, "force", false, "Reprocess all files even if -resume would otherwise skip them")
+	fs.BoolVar(&cleanStateFlag, "clean-state", false, "Discard .mayaspeechify-state.json before running")
+	fs.Float64Var(&rpsFlag, "rps", 0, "Max /synthesize requests per second shared across all workers (0 = unlimited)")
+	fs.Float64Var(&simulateFailureRateFlag, "simulate-failure-rate", 0, "internal: fraction (0.0-1.0) of requests to randomly fail pre-flight, for testing the retry path")
+	_ = fs.MarkHidden("simulate-failure-rate")
+	fs.StringVar(&transportFlag, "transport", "http", "Synthesis transport: http|grpc (grpc streams audio incrementally)")
+	fs.StringVar(&grpcServerFlag, "grpc-server", "", "gRPC server address, used when -transport=grpc (default: config.json grpc_server_url)")
+	fs.BoolVar(&stdoutFlag, "stdout", false, "Stream audio to stdout as it arrives (-transport=grpc only), for piping into ffplay/mpv")
+}
+
+// synthesizePath builds an app.AppConfig from the current flag values and
+// config.json, validates it, and runs the scan+synthesize pipeline
+// against scanPath. Shared by the legacy root command and the
+// synthesize/resume subcommands.
+func synthesizePath(scanPath string) error {
+	clientConfig := app.LoadConfig()
+
+	workers := workersFlag
+	if workers == 0 {
+		workers = clientConfig.Workers
+	}
+	server := serverFlag
+	if server == "" {
+		server = clientConfig.ServerURL
+	}
+	timeoutSeconds := timeoutFlag
+	if timeoutSeconds == 0 {
+		timeoutSeconds = clientConfig.Timeout
+	}
+	grpcServer := grpcServerFlag
+	if grpcServer == "" {
+		grpcServer = clientConfig.GRPCServerURL
+	}
+
+	presets := app.LoadVoicePresets()
+	voiceDescription, err := app.ResolveVoiceDescription(presets, voiceFlag, clientConfig.DefaultVoiceDescription)
+	if err != nil {
+		return err
+	}
+
+	config := app.AppConfig{
+		Workers:             workers,
+		ScanPath:            scanPath,
+		Recursive:           recursiveFlag,
+		Verbose:             verboseFlag,
+		ServerURL:           server,
+		Timeout:             time.Duration(timeoutSeconds) * time.Second,
+		VoiceDescription:    voiceDescription,
+		VoicePresets:        presets,
+		SplitMode:           splitFlag,
+		Format:              formatFlag,
+		Resume:              resumeFlag,
+		Force:               forceFlag,
+		CleanState:          cleanStateFlag,
+		Retry:               clientConfig.Retry,
+		RPS:                 rpsFlag,
+		SimulateFailureRate: simulateFailureRateFlag,
+		Transport:           transportFlag,
+		GRPCServerURL:       grpcServer,
+		Stdout:              stdoutFlag,
+	}
+
+	if err := validateSynthesizeConfig(&config); err != nil {
+		return err
+	}
+
+	logConfig(config)
+
+	return app.Run(config)
+}
+
+// validateSynthesizeConfig checks config for the same constraints the
+// original flat CLI enforced before running.
+func validateSynthesizeConfig(config *app.AppConfig) error {
+	if config.ScanPath == "" {
+		return fmt.Errorf("a scan path is required")
+	}
+	if _, err := os.Stat(config.ScanPath); os.IsNotExist(err) {
+		return fmt.Errorf("scan path does not exist: %s", config.ScanPath)
+	}
+	if config.Workers < 1 {
+		return fmt.Errorf("workers must be at least 1")
+	}
+	if config.Timeout < 1*time.Second {
+		return fmt.Errorf("timeout must be at least 1 second")
+	}
+	if _, err := app.SplitText("", config.SplitMode); err != nil {
+		return err
+	}
+	if config.Format != "mp3" && config.Format != "m4b" {
+		return fmt.Errorf("-format must be mp3 or m4b")
+	}
+	if config.RPS < 0 {
+		return fmt.Errorf("-rps must not be negative")
+	}
+	if config.SimulateFailureRate < 0 || config.SimulateFailureRate > 1 {
+		return fmt.Errorf("-simulate-failure-rate must be between 0.0 and 1.0")
+	}
+	if config.Transport != "http" && config.Transport != "grpc" {
+		return fmt.Errorf("-transport must be http or grpc")
+	}
+	if config.Transport == "grpc" && config.GRPCServerURL == "" {
+		return fmt.Errorf("-grpc-server is required when -transport=grpc")
+	}
+
+	return nil
+}
+
+// logConfig initializes the logger and, in verbose mode, prints the
+// resolved configuration.
+func logConfig(config app.AppConfig) {
+	if !config.Verbose {
+		log.SetOutput(os.Stderr)
+		log.SetFlags(0)
+		return
+	}
+
+	log.SetOutput(os.Stderr)
+	log.SetFlags(log.Ldate | log.Ltime | log.Lshortfile)
+
+	log.Printf("Configuration:")
+	log.Printf("  Workers: %d", config.Workers)
+	log.Printf("  Scan Path: %s", config.ScanPath)
+	log.Printf("  Recursive: %v", config.Recursive)
+	log.Printf("  Server URL: %s", config.ServerURL)
+	log.Printf("  Timeout: %v", config.Timeout)
+	log.Printf("  Voice Description: %q", config.VoiceDescription)
+	log.Printf("  Split: %s", config.SplitMode)
+	log.Printf("  Format: %s", config.Format)
+	log.Printf("  Rate limit: %.2f req/s (0 = unlimited)", config.RPS)
+	log.Printf("  Retry: max_attempts=%d initial_backoff=%.1fs max_backoff=%.1fs",
+		config.Retry.MaxAttempts, config.Retry.InitialBackoff, config.Retry.MaxBackoff)
+	log.Printf("  Transport: %s", config.Transport)
+	if config.Transport == "grpc" {
+		log.Printf("  gRPC Server: %s", config.GRPCServerURL)
+	}
+	log.Println()
+}
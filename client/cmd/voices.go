@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/KennethJefferson/MayaSpeechifier/client/internal/app"
+	"github.com/spf13/cobra"
+)
+
+// voicesCmd groups the voices subcommands; it has no behavior of its own.
+var voicesCmd = &cobra.Command{
+	Use:   "voices",
+	Short: "List or test named voice presets from voices.json",
+}
+
+var voicesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the voice presets available in voices.json",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVoicesList()
+	},
+}
+
+var voicesTestCmd = &cobra.Command{
+	Use:   "test <name>",
+	Short: "Synthesize a short sample clip with a named voice preset",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return runVoicesTest(args[0])
+	},
+}
+
+func init() {
+	voicesCmd.AddCommand(voicesListCmd)
+	voicesCmd.AddCommand(voicesTestCmd)
+	rootCmd.AddCommand(voicesCmd)
+}
+
+func runVoicesList() error {
+	presets := app.LoadVoicePresets()
+	if len(presets) == 0 {
+		fmt.Println("No voices.json found (or it has no presets) alongside the binary")
+		return nil
+	}
+
+	for name, preset := range presets {
+		fmt.Printf("%s: %s\n", name, preset.Description)
+	}
+
+	return nil
+}
+
+// voiceSampleText is the line synthesized by `voices test`; it's long
+// enough to judge the voice's timbre and short enough to stay fast.
+const voiceSampleText = "This is a sample of the selected voice, generated by mayaspeechify voices test."
+
+// runVoicesTest resolves name against voices.json and synthesizes
+// voiceSampleText with it. The server has no dedicated voice-listing
+// API, so "testing" a voice means exercising /synthesize directly and
+// listening to the result.
+func runVoicesTest(name string) error {
+	clientConfig := app.LoadConfig()
+
+	presets := app.LoadVoicePresets()
+	description, err := app.ResolveVoiceDescription(presets, name, "")
+	if err != nil {
+		return err
+	}
+
+	server := serverFlag
+	if server == "" {
+		server = clientConfig.ServerURL
+	}
+	timeoutSeconds := timeoutFlag
+	if timeoutSeconds == 0 {
+		timeoutSeconds = clientConfig.Timeout
+	}
+
+	outputPath := fmt.Sprintf("voice_test_%s.mp3", name)
+	if err := app.SynthesizeSample(server, time.Duration(timeoutSeconds)*time.Second, voiceSampleText, description, outputPath); err != nil {
+		return fmt.Errorf("failed to synthesize sample for voice %q: %w", name, err)
+	}
+
+	fmt.Printf("Wrote sample clip for voice %q to %s\n", name, outputPath)
+	return nil
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// AppConfig holds the fully-resolved runtime configuration for a single
+// scan-and-synthesize run. The cmd package builds one of these from CLI
+// flags and config.json before calling Run.
+type AppConfig struct {
+	Workers             int
+	ScanPath            string
+	Recursive           bool
+	Verbose             bool
+	ServerURL           string
+	Timeout             time.Duration
+	VoiceDescription    string
+	VoicePresets        map[string]VoicePreset
+	SplitMode           string
+	Format              string
+	Resume              bool
+	Force               bool
+	CleanState          bool
+	Retry               RetryConfig
+	RPS                 float64
+	SimulateFailureRate float64
+	Transport           string
+	GRPCServerURL       string
+	Stdout              bool
+}
+
+// Run scans config.ScanPath for .txt files, synthesizes each with a
+// worker pool, and prints a summary of the results.
+func Run(config AppConfig) error {
+	log.Println("Scanning for .txt files...")
+	files, err := DiscoverFiles(config.ScanPath, config.Recursive)
+	if err != nil {
+		return fmt.Errorf("failed to scan files: %w", err)
+	}
+
+	if len(files) == 0 {
+		log.Println("No .txt files found")
+		return nil
+	}
+
+	if config.Verbose {
+		log.Printf("Found %d text file(s)\n", len(files))
+	} else {
+		fmt.Printf("Found %d text file(s)\n", len(files))
+	}
+
+	results, err := ProcessFiles(files, config)
+	if err != nil {
+		return fmt.Errorf("failed to process files: %w", err)
+	}
+
+	printSummary(results, config.Verbose)
+
+	return nil
+}
+
+// printSummary prints processing results summary
+func printSummary(results []ProcessResult, verbose bool) {
+	successful := 0
+	failed := 0
+
+	for _, result := range results {
+		if result.Error == nil {
+			successful++
+		} else {
+			failed++
+		}
+	}
+
+	fmt.Println()
+	fmt.Println("=== Summary ===")
+	fmt.Printf("Total files: %d\n", len(results))
+	fmt.Printf("Successful: %d\n", successful)
+	fmt.Printf("Failed: %d\n", failed)
+
+	if failed > 0 && verbose {
+		fmt.Println("\nFailed files:")
+		for _, result := range results {
+			if result.Error != nil {
+				fmt.Printf("  - %s: %v\n", filepath.Base(result.FilePath), result.Error)
+			}
+		}
+	}
+}
+
+// getOutputPath generates the output MP3 path for a given input file
+func getOutputPath(inputPath string) string {
+	ext := filepath.Ext(inputPath)
+	return strings.TrimSuffix(inputPath, ext) + ".mp3"
+}
@@ -0,0 +1,130 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// estimatedBitrateBytesPerSec assumes a constant 128kbps CBR MP3 encoder
+// output. Chapter offsets derived from it are estimates, not exact frame
+// timing; exact timing would require decoding the MP3 frames.
+const estimatedBitrateBytesPerSec = 128 * 1024 / 8
+
+// ChapterEntry describes one chapter's position within the assembled
+// audio output, written to the chapters.json sidecar.
+type ChapterEntry struct {
+	Title        string  `json:"title"`
+	ByteOffset   int     `json:"byte_offset"`
+	StartSeconds float64 `json:"start_seconds"`
+}
+
+// assembleChunks concatenates chunk MP3 frames in order and returns the
+// combined bytes alongside a ChapterEntry per chunk. MP3 frames can be
+// concatenated directly without re-encoding.
+func assembleChunks(chunks []Chunk, mp3Parts [][]byte) ([]byte, []ChapterEntry) {
+	var combined []byte
+	entries := make([]ChapterEntry, 0, len(mp3Parts))
+
+	offset := 0
+	for i, part := range mp3Parts {
+		title := chunks[i].Title
+		if title == "" {
+			title = fmt.Sprintf("Part %d", i+1)
+		}
+
+		entries = append(entries, ChapterEntry{
+			Title:        title,
+			ByteOffset:   offset,
+			StartSeconds: float64(offset) / float64(estimatedBitrateBytesPerSec),
+		})
+
+		combined = append(combined, part...)
+		offset += len(part)
+	}
+
+	return combined, entries
+}
+
+// chaptersSidecarPath derives the chapters.json path for a given audio
+// output path, e.g. "book.mp3" -> "book.chapters.json".
+func chaptersSidecarPath(outputPath string) string {
+	ext := filepath.Ext(outputPath)
+	return strings.TrimSuffix(outputPath, ext) + ".chapters.json"
+}
+
+// writeChaptersSidecar writes the chapters.json sidecar for outputPath.
+func writeChaptersSidecar(outputPath string, entries []ChapterEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal chapters: %w", err)
+	}
+
+	if err := os.WriteFile(chaptersSidecarPath(outputPath), data, 0644); err != nil {
+		return fmt.Errorf("failed to write chapters sidecar: %w", err)
+	}
+
+	return nil
+}
+
+// muxToM4B converts an assembled MP3 file to a chaptered .m4b using
+// ffmpeg. Callers should treat a non-nil error as non-fatal and fall
+// back to the plain .mp3 output, since ffmpeg may not be installed.
+func muxToM4B(mp3Path string, entries []ChapterEntry, totalDuration time.Duration) (string, error) {
+	ffmpegPath, err := exec.LookPath("ffmpeg")
+	if err != nil {
+		return "", fmt.Errorf("ffmpeg not found on PATH: %w", err)
+	}
+
+	metadataPath := mp3Path + ".ffmetadata.txt"
+	if err := writeFFMetadata(metadataPath, entries, totalDuration); err != nil {
+		return "", err
+	}
+	defer os.Remove(metadataPath)
+
+	m4bPath := strings.TrimSuffix(mp3Path, filepath.Ext(mp3Path)) + ".m4b"
+
+	cmd := exec.Command(ffmpegPath,
+		"-y",
+		"-i", mp3Path,
+		"-i", metadataPath,
+		"-map_metadata", "1",
+		"-c:a", "aac",
+		"-f", "mp4",
+		m4bPath,
+	)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("ffmpeg failed: %w\n%s", err, output)
+	}
+
+	return m4bPath, nil
+}
+
+// writeFFMetadata writes an ffmetadata file describing chapter markers
+// for ffmpeg's -map_metadata mux. See https://ffmpeg.org/ffmpeg-formats.html#Metadata-2
+func writeFFMetadata(path string, entries []ChapterEntry, totalDuration time.Duration) error {
+	var sb strings.Builder
+	sb.WriteString(";FFMETADATA1\n")
+
+	for i, entry := range entries {
+		start := int64(entry.StartSeconds * 1000)
+		end := totalDuration.Milliseconds()
+		if i+1 < len(entries) {
+			end = int64(entries[i+1].StartSeconds * 1000)
+		}
+
+		sb.WriteString("[CHAPTER]\n")
+		sb.WriteString("TIMEBASE=1/1000\n")
+		sb.WriteString(fmt.Sprintf("START=%d\n", start))
+		sb.WriteString(fmt.Sprintf("END=%d\n", end))
+		sb.WriteString(fmt.Sprintf("title=%s\n", entry.Title))
+	}
+
+	return os.WriteFile(path, []byte(sb.String()), 0644)
+}
@@ -0,0 +1,156 @@
+package app
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Chunk is a single unit of text dispatched as its own /synthesize call.
+// Title is set when the chunk corresponds to a detected chapter heading.
+type Chunk struct {
+	Title string
+	Text  string
+}
+
+var chapterHeaderPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`^Chapter\s+\d+`),
+	regexp.MustCompile(`^\d+\.\s`),
+}
+
+var sentenceBoundaryRE = regexp.MustCompile(`[.!?]["')\]]?\s`)
+
+// SplitText divides text into chunks according to mode: "none" (default,
+// one chunk for the whole file), "paragraph" (split on blank lines),
+// "chapter" (split at chapter header lines), or "chars:N" (split on a
+// max-character budget, snapped back to the nearest sentence boundary).
+func SplitText(text string, mode string) ([]Chunk, error) {
+	switch {
+	case mode == "" || mode == "none":
+		return []Chunk{{Text: text}}, nil
+	case mode == "paragraph":
+		return splitByParagraph(text), nil
+	case mode == "chapter":
+		return splitByChapter(text), nil
+	case strings.HasPrefix(mode, "chars:"):
+		budget, err := strconv.Atoi(strings.TrimPrefix(mode, "chars:"))
+		if err != nil || budget <= 0 {
+			return nil, fmt.Errorf("invalid -split value %q: chars budget must be a positive integer", mode)
+		}
+		return splitByChars(text, budget), nil
+	default:
+		return nil, fmt.Errorf("invalid -split value %q: expected paragraph, chapter, chars:N, or none", mode)
+	}
+}
+
+// splitByParagraph splits text on blank lines, dropping empty paragraphs.
+func splitByParagraph(text string) []Chunk {
+	paragraphs := regexp.MustCompile(`\n\s*\n`).Split(text, -1)
+
+	var chunks []Chunk
+	for _, p := range paragraphs {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		chunks = append(chunks, Chunk{Text: p})
+	}
+
+	if len(chunks) == 0 {
+		return []Chunk{{Text: text}}
+	}
+
+	return chunks
+}
+
+// splitByChapter splits text at lines matching a chapter header pattern
+// (e.g. "Chapter 1", "2. The Beginning"), using the header line as the
+// chunk title. Text preceding the first detected header becomes an
+// untitled leading chunk.
+func splitByChapter(text string) []Chunk {
+	lines := strings.Split(text, "\n")
+
+	var chunks []Chunk
+	var title string
+	var body strings.Builder
+
+	flush := func() {
+		content := strings.TrimSpace(body.String())
+		if content == "" && title == "" {
+			return
+		}
+		chunks = append(chunks, Chunk{Title: title, Text: content})
+		body.Reset()
+	}
+
+	for _, line := range lines {
+		if isChapterHeader(line) {
+			flush()
+			title = strings.TrimSpace(line)
+			continue
+		}
+		body.WriteString(line)
+		body.WriteByte('\n')
+	}
+	flush()
+
+	if len(chunks) == 0 {
+		return []Chunk{{Text: text}}
+	}
+
+	return chunks
+}
+
+func isChapterHeader(line string) bool {
+	trimmed := strings.TrimSpace(line)
+	for _, re := range chapterHeaderPatterns {
+		if re.MatchString(trimmed) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitByChars splits text into chunks of at most budget characters,
+// snapping each cut back to the nearest preceding sentence boundary so
+// chunks don't end mid-sentence. Operates on runes, not bytes, so a cut
+// never lands inside a multi-byte UTF-8 sequence.
+func splitByChars(text string, budget int) []Chunk {
+	remaining := []rune(text)
+	var chunks []Chunk
+
+	for len(remaining) > budget {
+		cut := budget
+		if idx := lastSentenceBoundary(string(remaining[:cut])); idx > 0 {
+			cut = idx
+		}
+
+		if chunk := strings.TrimSpace(string(remaining[:cut])); chunk != "" {
+			chunks = append(chunks, Chunk{Text: chunk})
+		}
+		remaining = remaining[cut:]
+	}
+
+	if rest := strings.TrimSpace(string(remaining)); rest != "" {
+		chunks = append(chunks, Chunk{Text: rest})
+	}
+
+	if len(chunks) == 0 {
+		return []Chunk{{Text: text}}
+	}
+
+	return chunks
+}
+
+// lastSentenceBoundary returns the rune index just past the last sentence
+// terminator in s, or -1 if none is found.
+func lastSentenceBoundary(s string) int {
+	matches := sentenceBoundaryRE.FindAllStringIndex(s, -1)
+	if len(matches) == 0 {
+		return -1
+	}
+
+	return utf8.RuneCountInString(s[:matches[len(matches)-1][1]])
+}
@@ -0,0 +1,111 @@
+package app
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitTextNone(t *testing.T) {
+	chunks, err := SplitText("hello world", "none")
+	if err != nil {
+		t.Fatalf("SplitText returned error: %v", err)
+	}
+	if len(chunks) != 1 || chunks[0].Text != "hello world" {
+		t.Fatalf("expected a single unmodified chunk, got %+v", chunks)
+	}
+}
+
+func TestSplitTextParagraph(t *testing.T) {
+	text := "First paragraph.\n\nSecond paragraph.\n\n\nThird paragraph."
+
+	chunks, err := SplitText(text, "paragraph")
+	if err != nil {
+		t.Fatalf("SplitText returned error: %v", err)
+	}
+
+	want := []string{"First paragraph.", "Second paragraph.", "Third paragraph."}
+	if len(chunks) != len(want) {
+		t.Fatalf("got %d chunks, want %d: %+v", len(chunks), len(want), chunks)
+	}
+	for i, w := range want {
+		if chunks[i].Text != w {
+			t.Errorf("chunk %d = %q, want %q", i, chunks[i].Text, w)
+		}
+	}
+}
+
+func TestSplitTextChapter(t *testing.T) {
+	text := "Chapter 1\nFirst chapter body.\nChapter 2\nSecond chapter body."
+
+	chunks, err := SplitText(text, "chapter")
+	if err != nil {
+		t.Fatalf("SplitText returned error: %v", err)
+	}
+
+	if len(chunks) != 2 {
+		t.Fatalf("got %d chunks, want 2: %+v", len(chunks), chunks)
+	}
+	if chunks[0].Title != "Chapter 1" || chunks[0].Text != "First chapter body." {
+		t.Errorf("chunk 0 = %+v", chunks[0])
+	}
+	if chunks[1].Title != "Chapter 2" || chunks[1].Text != "Second chapter body." {
+		t.Errorf("chunk 1 = %+v", chunks[1])
+	}
+}
+
+func TestSplitTextCharsASCII(t *testing.T) {
+	chunks, err := SplitText("abcdefghij", "chars:4")
+	if err != nil {
+		t.Fatalf("SplitText returned error: %v", err)
+	}
+
+	var rebuilt strings.Builder
+	for _, c := range chunks {
+		rebuilt.WriteString(c.Text)
+	}
+	if rebuilt.String() != "abcdefghij" {
+		t.Errorf("chunks don't reassemble to the original text: %+v", chunks)
+	}
+}
+
+// TestSplitTextCharsNonASCII exercises chars:N on text whose budget cuts
+// fall inside multi-byte runes, to guard against regressions back to
+// byte-index slicing of the kind that produced invalid UTF-8.
+func TestSplitTextCharsNonASCII(t *testing.T) {
+	text := "café déjà vu — naïve"
+
+	for budget := 1; budget <= utf8.RuneCountInString(text); budget++ {
+		chunks, err := SplitText(text, "chars:"+strconv.Itoa(budget))
+		if err != nil {
+			t.Fatalf("SplitText(%q, chars:%d) returned error: %v", text, budget, err)
+		}
+
+		for _, c := range chunks {
+			if !utf8.ValidString(c.Text) {
+				t.Fatalf("budget %d: chunk %q is not valid UTF-8", budget, c.Text)
+			}
+		}
+
+		var rebuilt strings.Builder
+		for _, c := range chunks {
+			rebuilt.WriteString(c.Text)
+		}
+		if got := strings.ReplaceAll(rebuilt.String(), " ", ""); got != strings.ReplaceAll(text, " ", "") {
+			t.Fatalf("budget %d: chunks don't reassemble to the original text (modulo trimmed spaces): got %q", budget, rebuilt.String())
+		}
+	}
+}
+
+func TestSplitTextInvalidMode(t *testing.T) {
+	if _, err := SplitText("text", "bogus"); err == nil {
+		t.Fatal("expected an error for an invalid -split mode, got nil")
+	}
+	if _, err := SplitText("text", "chars:0"); err == nil {
+		t.Fatal("expected an error for a non-positive chars budget, got nil")
+	}
+	if _, err := SplitText("text", "chars:nope"); err == nil {
+		t.Fatal("expected an error for a non-numeric chars budget, got nil")
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package app
 
 import (
 	"encoding/json"
@@ -10,9 +10,12 @@ import (
 
 // ClientConfig holds the client configuration
 type ClientConfig struct {
-	ServerURL string `json:"server_url"`
-	Timeout   int    `json:"timeout"`   // in seconds
-	Workers   int    `json:"workers"`
+	ServerURL               string      `json:"server_url"`
+	Timeout                 int         `json:"timeout"` // in seconds
+	Workers                 int         `json:"workers"`
+	DefaultVoiceDescription string      `json:"default_voice_description"`
+	Retry                   RetryConfig `json:"retry"`
+	GRPCServerURL           string      `json:"grpc_server_url"`
 }
 
 // DefaultConfig returns the default configuration
@@ -21,6 +24,7 @@ func DefaultConfig() ClientConfig {
 		ServerURL: "http://localhost:8000",
 		Timeout:   600, // 10 minutes
 		Workers:   1,
+		Retry:     DefaultRetryConfig(),
 	}
 }
 
@@ -112,6 +116,13 @@ func validateConfig(config *ClientConfig) error {
 		log.Printf("Warning: Workers count %d seems high, consider reducing", config.Workers)
 	}
 
+	// Validate retry policy
+	if config.Retry.MaxAttempts < 1 {
+		log.Printf("Warning: Invalid retry.max_attempts %d, using default (%d)",
+			config.Retry.MaxAttempts, DefaultRetryConfig().MaxAttempts)
+		config.Retry = DefaultRetryConfig()
+	}
+
 	return nil
 }
 
@@ -126,6 +137,12 @@ func createExampleConfig(path string) error {
 		"_timeout_note": "HTTP request timeout in seconds (can be overridden with -timeout flag)",
 		"workers": 1,
 		"_workers_note": "Default number of parallel workers (can be overridden with -workers flag)",
+		"default_voice_description": "",
+		"_default_voice_description_note": "Voice description sent when no -voice preset or file frontmatter overrides it",
+		"retry": DefaultRetryConfig(),
+		"_retry_note": "Retry/backoff policy for /synthesize requests; sleep = min(max_backoff_seconds, initial_backoff_seconds * multiplier^(attempt-1)) jittered by jitter_fraction",
+		"grpc_server_url": "localhost:9000",
+		"_grpc_server_url_note": "gRPC server address used when -transport=grpc (can be overridden with -grpc-server flag)",
 		"_examples": []string{
 			"Local server: http://localhost:8000",
 			"Remote server: http://192.168.1.100:8000",
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FileFrontMatter holds per-file synthesis overrides parsed from a
+// `---` delimited frontmatter block at the top of a .txt file.
+type FileFrontMatter struct {
+	Voice            string
+	VoiceDescription string
+	Speed            *float64
+}
+
+// extractFrontMatter strips a leading `---`-delimited frontmatter block
+// from content and parses its "key: value" pairs. If content has no
+// frontmatter block, it is returned unchanged alongside a zero-value
+// FileFrontMatter.
+func extractFrontMatter(content []byte) (FileFrontMatter, []byte, error) {
+	var fm FileFrontMatter
+
+	trimmed := bytes.TrimLeft(content, "\r\n")
+	if !bytes.HasPrefix(trimmed, []byte("---")) {
+		return fm, content, nil
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(trimmed))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != "---" {
+		return fm, content, nil
+	}
+
+	closed := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "---" {
+			closed = true
+			break
+		}
+
+		if err := parseFrontMatterLine(line, &fm); err != nil {
+			return FileFrontMatter{}, content, err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return FileFrontMatter{}, content, fmt.Errorf("failed to scan frontmatter: %w", err)
+	}
+
+	if !closed {
+		// No closing delimiter found; treat the whole file as body text.
+		return FileFrontMatter{}, content, nil
+	}
+
+	var body bytes.Buffer
+	for scanner.Scan() {
+		body.WriteString(scanner.Text())
+		body.WriteByte('\n')
+	}
+
+	return fm, body.Bytes(), nil
+}
+
+// parseFrontMatterLine parses a single "key: value" or "key = value" line,
+// accepting the common YAML and TOML spellings, and stores it on fm.
+func parseFrontMatterLine(line string, fm *FileFrontMatter) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	sep := strings.IndexAny(line, ":=")
+	if sep == -1 {
+		return fmt.Errorf("invalid frontmatter line: %q", line)
+	}
+
+	key := strings.ToLower(strings.TrimSpace(line[:sep]))
+	value := strings.Trim(strings.TrimSpace(line[sep+1:]), `"'`)
+
+	switch key {
+	case "voice":
+		fm.Voice = value
+	case "voice_description", "voice-description":
+		fm.VoiceDescription = value
+	case "speed":
+		speed, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid speed %q: %w", value, err)
+		}
+		fm.Speed = &speed
+	}
+
+	return nil
+}
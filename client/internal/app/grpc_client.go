@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/KennethJefferson/MayaSpeechifier/client/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// dialGRPC opens a connection to the gRPC server at serverURL, for the
+// streaming transport alternative to HTTP (-transport=grpc).
+func dialGRPC(serverURL string) (*grpc.ClientConn, error) {
+	conn, err := grpc.NewClient(serverURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial grpc server: %w", err)
+	}
+
+	return conn, nil
+}
+
+// synthesizeChunkGRPC sends a single chunk of text over the streaming
+// gRPC transport and returns the concatenated MP3 bytes. Modeled on the
+// follower/streaming pattern used by log-tail RPCs: open the stream,
+// range over Recv() until EOF, and append each chunk as it arrives.
+//
+// It deliberately does not write to stdout itself: the caller retries a
+// failed attempt from scratch, and a mid-stream error after some chunks
+// had already been flushed would duplicate that audio on replay. The
+// caller writes the combined result to stdout once an attempt fully
+// succeeds instead.
+func synthesizeChunkGRPC(ctx context.Context, conn *grpc.ClientConn, text, voiceDescription string, speed *float64) ([]byte, error) {
+	client := pb.NewMayaSpeechifyClient(conn)
+
+	req := &pb.SynthesizeRequest{Text: text, Speed: speed}
+	if voiceDescription != "" {
+		req.VoiceDescription = &voiceDescription
+	}
+
+	stream, err := client.Synthesize(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open synthesize stream: %w", err)
+	}
+
+	var combined []byte
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("stream recv failed: %w", err)
+		}
+
+		combined = append(combined, chunk.Data...)
+
+		if chunk.IsFinal {
+			break
+		}
+	}
+
+	return combined, nil
+}
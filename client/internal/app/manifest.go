@@ -0,0 +1,209 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const manifestFileName = ".mayaspeechify-state.json"
+
+// JobStatus is the lifecycle state of a single file's processing job,
+// tracked across runs so interrupted batches can be resumed.
+type JobStatus string
+
+const (
+	JobPending  JobStatus = "pending"
+	JobInflight JobStatus = "inflight"
+	JobDone     JobStatus = "done"
+	JobFailed   JobStatus = "failed"
+)
+
+// JobEntry tracks the processing state of a single input file.
+type JobEntry struct {
+	InputPath   string        `json:"input_path"`
+	InputSHA256 string        `json:"input_sha256"`
+	OutputPath  string        `json:"output_path"`
+	Status      JobStatus     `json:"status"`
+	Attempts    int           `json:"attempts"`
+	LastError   string        `json:"last_error,omitempty"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// Manifest is the persisted job-state file for a batch run, keyed by
+// input path. It makes multi-hour batches over thousands of files safe
+// against Ctrl-C, network blips, or a machine reboot.
+type Manifest struct {
+	mu      sync.Mutex
+	path    string
+	Entries map[string]*JobEntry `json:"entries"`
+}
+
+// loadOrInitManifest loads the manifest from scanRoot, or returns an
+// empty one if none exists yet.
+func loadOrInitManifest(scanRoot string) (*Manifest, error) {
+	path := filepath.Join(scanRoot, manifestFileName)
+
+	m := &Manifest{path: path, Entries: map[string]*JobEntry{}}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job manifest: %w", err)
+	}
+
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse job manifest: %w", err)
+	}
+	if m.Entries == nil {
+		m.Entries = map[string]*JobEntry{}
+	}
+	m.path = path
+
+	return m, nil
+}
+
+// removeManifest deletes the manifest file at scanRoot, if present.
+func removeManifest(scanRoot string) error {
+	path := filepath.Join(scanRoot, manifestFileName)
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove job manifest: %w", err)
+	}
+
+	return nil
+}
+
+// entryFor returns the JobEntry for inputPath, creating a pending one
+// if it doesn't exist yet.
+func (m *Manifest) entryFor(inputPath, outputPath string) *JobEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if entry, ok := m.Entries[inputPath]; ok {
+		return entry
+	}
+
+	entry := &JobEntry{
+		InputPath:  inputPath,
+		OutputPath: outputPath,
+		Status:     JobPending,
+	}
+	m.Entries[inputPath] = entry
+
+	return entry
+}
+
+// markInflight records that inputPath has started processing.
+func (m *Manifest) markInflight(inputPath string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.Entries[inputPath]
+	entry.Status = JobInflight
+	entry.Attempts++
+}
+
+// markDone records a successful run of inputPath.
+func (m *Manifest) markDone(inputPath, outputPath string, duration time.Duration) {
+	sha, err := sha256OfFile(inputPath)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.Entries[inputPath]
+	entry.Status = JobDone
+	entry.OutputPath = outputPath
+	entry.Duration = duration
+	entry.LastError = ""
+	if err == nil {
+		entry.InputSHA256 = sha
+	}
+}
+
+// markFailed records a failed run of inputPath.
+func (m *Manifest) markFailed(inputPath string, runErr error, duration time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := m.Entries[inputPath]
+	entry.Status = JobFailed
+	entry.Duration = duration
+	entry.LastError = runErr.Error()
+}
+
+// reset clears all entries, used by -clean-state to discard prior history.
+func (m *Manifest) reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.Entries = map[string]*JobEntry{}
+}
+
+// isResumable reports whether inputPath's recorded entry is done and
+// still matches the file on disk, meaning it can be safely skipped.
+func (m *Manifest) isResumable(inputPath string) bool {
+	m.mu.Lock()
+	entry, ok := m.Entries[inputPath]
+	m.mu.Unlock()
+
+	if !ok || entry.Status != JobDone {
+		return false
+	}
+
+	if !fileExists(entry.OutputPath) {
+		return false
+	}
+
+	sha, err := sha256OfFile(inputPath)
+	if err != nil {
+		return false
+	}
+
+	return sha == entry.InputSHA256
+}
+
+// save persists the manifest to disk as JSON. The lock is held across both
+// the marshal and the write: save is called from every worker goroutine
+// after each completed file, and releasing the lock before os.WriteFile
+// would let concurrent workers (-workers > 1) interleave writes and leave
+// a truncated or corrupt manifest on disk.
+func (m *Manifest) save() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal job manifest: %w", err)
+	}
+
+	if err := os.WriteFile(m.path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write job manifest: %w", err)
+	}
+
+	return nil
+}
+
+// sha256OfFile returns the hex-encoded sha256 digest of a file's contents.
+func sha256OfFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,159 @@
+package app
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestManifestResumeSkipsUnchangedCompletedFile(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "book.txt")
+	output := filepath.Join(dir, "book.mp3")
+
+	if err := os.WriteFile(input, []byte("hello"), 0644); err != nil {
+		t.Fatalf("failed to write input fixture: %v", err)
+	}
+	if err := os.WriteFile(output, []byte("mp3 bytes"), 0644); err != nil {
+		t.Fatalf("failed to write output fixture: %v", err)
+	}
+
+	m, err := loadOrInitManifest(dir)
+	if err != nil {
+		t.Fatalf("loadOrInitManifest returned error: %v", err)
+	}
+
+	m.entryFor(input, output)
+	if m.isResumable(input) {
+		t.Fatal("a pending entry should not be resumable")
+	}
+
+	m.markInflight(input)
+	if m.isResumable(input) {
+		t.Fatal("an inflight entry should not be resumable")
+	}
+
+	m.markDone(input, output, time.Second)
+	if !m.isResumable(input) {
+		t.Fatal("a done entry matching the file on disk should be resumable")
+	}
+}
+
+func TestManifestResumeRejectsChangedInput(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "book.txt")
+	output := filepath.Join(dir, "book.mp3")
+
+	if err := os.WriteFile(input, []byte("original contents"), 0644); err != nil {
+		t.Fatalf("failed to write input fixture: %v", err)
+	}
+	if err := os.WriteFile(output, []byte("mp3 bytes"), 0644); err != nil {
+		t.Fatalf("failed to write output fixture: %v", err)
+	}
+
+	m, err := loadOrInitManifest(dir)
+	if err != nil {
+		t.Fatalf("loadOrInitManifest returned error: %v", err)
+	}
+	m.entryFor(input, output)
+	m.markDone(input, output, time.Second)
+
+	if !m.isResumable(input) {
+		t.Fatal("expected entry to be resumable before the input changed")
+	}
+
+	if err := os.WriteFile(input, []byte("edited contents"), 0644); err != nil {
+		t.Fatalf("failed to rewrite input fixture: %v", err)
+	}
+
+	if m.isResumable(input) {
+		t.Fatal("an entry whose input sha256 no longer matches should not be resumable")
+	}
+}
+
+func TestManifestResumeRejectsMissingOutput(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "book.txt")
+	output := filepath.Join(dir, "book.mp3")
+
+	if err := os.WriteFile(input, []byte("hello"), 0644); err != nil {
+		t.Fatalf("failed to write input fixture: %v", err)
+	}
+	if err := os.WriteFile(output, []byte("mp3 bytes"), 0644); err != nil {
+		t.Fatalf("failed to write output fixture: %v", err)
+	}
+
+	m, err := loadOrInitManifest(dir)
+	if err != nil {
+		t.Fatalf("loadOrInitManifest returned error: %v", err)
+	}
+	m.entryFor(input, output)
+	m.markDone(input, output, time.Second)
+
+	if err := os.Remove(output); err != nil {
+		t.Fatalf("failed to remove output fixture: %v", err)
+	}
+
+	if m.isResumable(input) {
+		t.Fatal("an entry whose output file is missing should not be resumable")
+	}
+}
+
+func TestManifestSaveAndReload(t *testing.T) {
+	dir := t.TempDir()
+	input := filepath.Join(dir, "book.txt")
+	output := filepath.Join(dir, "book.mp3")
+
+	if err := os.WriteFile(input, []byte("hello"), 0644); err != nil {
+		t.Fatalf("failed to write input fixture: %v", err)
+	}
+	if err := os.WriteFile(output, []byte("mp3 bytes"), 0644); err != nil {
+		t.Fatalf("failed to write output fixture: %v", err)
+	}
+
+	m, err := loadOrInitManifest(dir)
+	if err != nil {
+		t.Fatalf("loadOrInitManifest returned error: %v", err)
+	}
+	m.entryFor(input, output)
+	m.markDone(input, output, time.Second)
+
+	if err := m.save(); err != nil {
+		t.Fatalf("save returned error: %v", err)
+	}
+
+	reloaded, err := loadOrInitManifest(dir)
+	if err != nil {
+		t.Fatalf("loadOrInitManifest (reload) returned error: %v", err)
+	}
+
+	if !reloaded.isResumable(input) {
+		t.Fatal("expected the reloaded manifest to consider the entry resumable")
+	}
+}
+
+func TestSHA256OfFileChangesWithContent(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "file.txt")
+
+	if err := os.WriteFile(path, []byte("one"), 0644); err != nil {
+		t.Fatalf("failed to write fixture: %v", err)
+	}
+	shaOne, err := sha256OfFile(path)
+	if err != nil {
+		t.Fatalf("sha256OfFile returned error: %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("two"), 0644); err != nil {
+		t.Fatalf("failed to rewrite fixture: %v", err)
+	}
+	shaTwo, err := sha256OfFile(path)
+	if err != nil {
+		t.Fatalf("sha256OfFile returned error: %v", err)
+	}
+
+	if shaOne == shaTwo {
+		t.Fatal("expected sha256OfFile to change when file contents change")
+	}
+}
@@ -0,0 +1,67 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a token-bucket rate limiter shared across all workers so
+// parallel workers don't overwhelm a single-GPU Maya1 server. A nil
+// bucket, or one created with rps <= 0, disables limiting entirely.
+type TokenBucket struct {
+	mu       sync.Mutex
+	tokens   float64
+	capacity float64
+	rps      float64
+	last     time.Time
+}
+
+// newTokenBucket creates a bucket allowing rps requests per second, with
+// burst capacity equal to one second's worth of tokens.
+func newTokenBucket(rps float64) *TokenBucket {
+	if rps <= 0 {
+		return nil
+	}
+
+	return &TokenBucket{
+		tokens:   rps,
+		capacity: rps,
+		rps:      rps,
+		last:     time.Now(),
+	}
+}
+
+// Wait blocks until a token is available, or ctx is done.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+
+	for {
+		b.mu.Lock()
+		now := time.Now()
+		b.tokens += now.Sub(b.last).Seconds() * b.rps
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+		b.last = now
+
+		if b.tokens >= 1 {
+			b.tokens--
+			b.mu.Unlock()
+			return nil
+		}
+
+		wait := time.Duration((1 - b.tokens) / b.rps * float64(time.Second))
+		b.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryConfig controls the retry/backoff policy for /synthesize requests,
+// tuned for a flaky, GPU-backed inference server.
+type RetryConfig struct {
+	MaxAttempts    int     `json:"max_attempts"`
+	InitialBackoff float64 `json:"initial_backoff_seconds"`
+	MaxBackoff     float64 `json:"max_backoff_seconds"`
+	Multiplier     float64 `json:"multiplier"`
+	JitterFraction float64 `json:"jitter_fraction"`
+}
+
+// DefaultRetryConfig returns a conservative retry policy.
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:    5,
+		InitialBackoff: 1,
+		MaxBackoff:     30,
+		Multiplier:     2,
+		JitterFraction: 0.2,
+	}
+}
+
+// backoffDuration computes the sleep before the given attempt (1-indexed):
+// min(MaxBackoff, InitialBackoff * Multiplier^(attempt-1)), with random
+// jitter in [1-JitterFraction, 1+JitterFraction].
+func (r RetryConfig) backoffDuration(attempt int) time.Duration {
+	base := r.InitialBackoff * math.Pow(r.Multiplier, float64(attempt-1))
+	if base > r.MaxBackoff {
+		base = r.MaxBackoff
+	}
+
+	jitter := 1.0
+	if r.JitterFraction > 0 {
+		jitter = 1 - r.JitterFraction + rand.Float64()*2*r.JitterFraction
+	}
+
+	return time.Duration(base * jitter * float64(time.Second))
+}
+
+// httpStatusError wraps a non-2xx /synthesize response so callers can
+// decide whether it's worth retrying.
+type httpStatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("server returned status %d: %s", e.StatusCode, e.Body)
+}
+
+// isRetryableError reports whether err is worth retrying: network errors,
+// a context deadline, an HTTP 5xx/429 response, or the grpc-transport
+// equivalents of those.
+func isRetryableError(err error) bool {
+	var statusErr *httpStatusError
+	if errors.As(err, &statusErr) {
+		return isRetryableStatus(statusErr.StatusCode)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	if s, ok := status.FromError(err); ok {
+		return isRetryableGRPCCode(s.Code())
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// isRetryableStatus reports whether an HTTP status code should be retried.
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= 500
+}
+
+// isRetryableGRPCCode reports whether a grpc status code should be
+// retried: transient, server-side conditions analogous to HTTP 5xx/429.
+func isRetryableGRPCCode(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,4 +1,4 @@
-package main
+package app
 
 import (
 	"log"
@@ -7,8 +7,8 @@ import (
 	"strings"
 )
 
-// discoverFiles scans the given directory for .txt files
-func discoverFiles(rootPath string, recursive bool) ([]string, error) {
+// DiscoverFiles scans the given directory for .txt files
+func DiscoverFiles(rootPath string, recursive bool) ([]string, error) {
 	var files []string
 
 	if recursive {
@@ -59,10 +59,12 @@ func discoverFiles(rootPath string, recursive bool) ([]string, error) {
 	return files, nil
 }
 
-// fileExists checks if a file exists at the given path
+// fileExists checks if a file exists at the given path. Any stat error -
+// not just os.IsNotExist, since a permission error or a stale NFS handle
+// leaves info nil too - is treated as "doesn't exist" rather than trusted.
 func fileExists(path string) bool {
 	info, err := os.Stat(path)
-	if os.IsNotExist(err) {
+	if err != nil {
 		return false
 	}
 	return !info.IsDir()
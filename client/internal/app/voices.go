@@ -0,0 +1,58 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// VoicePreset describes a named, reusable voice description that can be
+// selected by name via the -voice flag or a file's frontmatter.
+type VoicePreset struct {
+	Description string `json:"description"`
+}
+
+// LoadVoicePresets loads voices.json from the executable directory. A
+// missing file is not an error: callers get an empty preset map and fall
+// back to -voice-description or the config default.
+func LoadVoicePresets() map[string]VoicePreset {
+	exePath, err := os.Executable()
+	if err != nil {
+		log.Printf("Warning: Could not get executable path: %v", err)
+		return map[string]VoicePreset{}
+	}
+
+	path := filepath.Join(filepath.Dir(exePath), "voices.json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Printf("Warning: Failed to read voices.json: %v", err)
+		}
+		return map[string]VoicePreset{}
+	}
+
+	var presets map[string]VoicePreset
+	if err := json.Unmarshal(data, &presets); err != nil {
+		log.Printf("Warning: Failed to parse voices.json: %v", err)
+		return map[string]VoicePreset{}
+	}
+
+	return presets
+}
+
+// ResolveVoiceDescription looks up name in presets and returns its
+// description. If name is empty, fallback is returned instead.
+func ResolveVoiceDescription(presets map[string]VoicePreset, name, fallback string) (string, error) {
+	if name == "" {
+		return fallback, nil
+	}
+
+	preset, ok := presets[name]
+	if !ok {
+		return "", fmt.Errorf("unknown voice preset: %q (check voices.json)", name)
+	}
+
+	return preset.Description, nil
+}
@@ -0,0 +1,411 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ProcessResult represents the result of processing a single file
+type ProcessResult struct {
+	FilePath   string
+	OutputPath string
+	Duration   time.Duration
+	Error      error
+}
+
+// SynthesizeRequest matches the server's API request format
+type SynthesizeRequest struct {
+	Text             string   `json:"text"`
+	VoiceDescription *string  `json:"voice_description,omitempty"`
+	Speed            *float64 `json:"speed,omitempty"`
+}
+
+// ProcessFiles processes all files using a worker pool. It maintains a
+// persistent job manifest (.mayaspeechify-state.json) in the scan root so
+// that interrupted runs can be resumed with -resume.
+func ProcessFiles(files []string, config AppConfig) ([]ProcessResult, error) {
+	if config.CleanState {
+		if err := removeManifest(config.ScanPath); err != nil {
+			return nil, err
+		}
+	}
+
+	manifest, err := loadOrInitManifest(config.ScanPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var toProcess []string
+	for _, file := range files {
+		manifest.entryFor(file, getOutputPath(file))
+
+		if config.Resume && !config.Force && manifest.isResumable(file) {
+			if config.Verbose {
+				log.Printf("Skipping already-completed file: %s", filepath.Base(file))
+			}
+			continue
+		}
+
+		toProcess = append(toProcess, file)
+	}
+
+	if err := manifest.save(); err != nil {
+		return nil, err
+	}
+
+	numWorkers := config.Workers
+	if numWorkers > len(toProcess) {
+		numWorkers = len(toProcess)
+	}
+
+	// Create channels
+	jobs := make(chan string, len(toProcess))
+	results := make(chan ProcessResult, len(toProcess))
+
+	// Create progress bar
+	bar := createProgressBar(len(toProcess), config.Verbose)
+
+	// Shared across all workers so parallel requests don't overwhelm a
+	// single-GPU inference server.
+	limiter := newTokenBucket(config.RPS)
+
+	// Start workers
+	var wg sync.WaitGroup
+	for i := 0; i < numWorkers; i++ {
+		wg.Add(1)
+		go worker(i+1, jobs, results, &wg, config, bar, manifest, limiter)
+	}
+
+	// Send jobs
+	for _, file := range toProcess {
+		jobs <- file
+	}
+	close(jobs)
+
+	// Wait for all workers to finish
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// Collect results
+	var allResults []ProcessResult
+	for result := range results {
+		allResults = append(allResults, result)
+	}
+
+	// Finish progress bar
+	if bar != nil {
+		bar.Finish()
+	}
+
+	return allResults, nil
+}
+
+// worker processes files from the jobs channel
+func worker(id int, jobs <-chan string, results chan<- ProcessResult,
+	wg *sync.WaitGroup, config AppConfig, bar ProgressBar, manifest *Manifest, limiter *TokenBucket) {
+	defer wg.Done()
+
+	client := &http.Client{
+		Timeout: config.Timeout,
+	}
+
+	var grpcConn *grpc.ClientConn
+	if config.Transport == "grpc" {
+		conn, err := dialGRPC(config.GRPCServerURL)
+		if err != nil {
+			log.Printf("[Worker %d] Failed to dial grpc server: %v", id, err)
+			return
+		}
+		defer conn.Close()
+		grpcConn = conn
+	}
+
+	if config.Verbose {
+		log.Printf("[Worker %d] Starting with timeout: %v", id, config.Timeout)
+	}
+
+	for filePath := range jobs {
+		if config.Verbose {
+			log.Printf("[Worker %d] Processing: %s", id, filepath.Base(filePath))
+		}
+
+		startTime := time.Now()
+		result := ProcessResult{
+			FilePath: filePath,
+		}
+
+		manifest.markInflight(filePath)
+
+		// Process the file
+		outputPath, err := processSingleFile(filePath, client, grpcConn, config, limiter)
+		result.OutputPath = outputPath
+		result.Duration = time.Since(startTime)
+		result.Error = err
+
+		if err != nil {
+			manifest.markFailed(filePath, err, result.Duration)
+			if config.Verbose {
+				log.Printf("[Worker %d] Failed: %s - %v", id, filepath.Base(filePath), err)
+			}
+		} else {
+			manifest.markDone(filePath, outputPath, result.Duration)
+			if config.Verbose {
+				log.Printf("[Worker %d] Success: %s -> %s (%.2fs)",
+					id, filepath.Base(filePath), filepath.Base(outputPath), result.Duration.Seconds())
+			}
+		}
+
+		if err := manifest.save(); err != nil && config.Verbose {
+			log.Printf("[Worker %d] Warning: failed to save job manifest: %v", id, err)
+		}
+
+		results <- result
+
+		// Update progress bar
+		if bar != nil {
+			bar.Add(1)
+		}
+	}
+
+	if config.Verbose {
+		log.Printf("[Worker %d] Finished", id)
+	}
+}
+
+// processSingleFile handles the processing of a single text file
+func processSingleFile(filePath string, client *http.Client, grpcConn *grpc.ClientConn, config AppConfig, limiter *TokenBucket) (string, error) {
+	// Read file contents
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	// Strip any per-file frontmatter and merge its overrides into the
+	// request, falling back to the voice resolved from -voice/config.
+	fm, body, err := extractFrontMatter(content)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse frontmatter: %w", err)
+	}
+
+	voiceDescription := config.VoiceDescription
+	if fm.Voice != "" {
+		presetDescription, err := ResolveVoiceDescription(config.VoicePresets, fm.Voice, voiceDescription)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve frontmatter voice: %w", err)
+		}
+		voiceDescription = presetDescription
+	}
+	if fm.VoiceDescription != "" {
+		voiceDescription = fm.VoiceDescription
+	}
+
+	// Split into chunks so long books don't ride on a single request
+	// that can time out; -split=none (the default) yields one chunk.
+	chunks, err := SplitText(string(body), config.SplitMode)
+	if err != nil {
+		return "", err
+	}
+
+	var stdout io.Writer
+	if config.Stdout {
+		stdout = os.Stdout
+	}
+
+	mp3Parts := make([][]byte, len(chunks))
+	for i, chunk := range chunks {
+		mp3Data, err := synthesizeChunk(context.Background(), client, grpcConn, limiter, config, chunk.Text, voiceDescription, fm.Speed, stdout)
+		if err != nil {
+			return "", fmt.Errorf("failed to synthesize chunk %d/%d: %w", i+1, len(chunks), err)
+		}
+		mp3Parts[i] = mp3Data
+	}
+
+	combined, chapters := assembleChunks(chunks, mp3Parts)
+
+	// Generate output path (same directory as input, .mp3 extension)
+	outputPath := getOutputPath(filePath)
+
+	if err := os.WriteFile(outputPath, combined, 0644); err != nil {
+		return "", fmt.Errorf("failed to write MP3 file: %w", err)
+	}
+
+	if len(chunks) > 1 {
+		if err := writeChaptersSidecar(outputPath, chapters); err != nil {
+			return "", err
+		}
+	}
+
+	if config.Format == "m4b" {
+		totalDuration := time.Duration(float64(len(combined))/float64(estimatedBitrateBytesPerSec)) * time.Second
+		m4bPath, err := muxToM4B(outputPath, chapters, totalDuration)
+		if err != nil {
+			log.Printf("Warning: m4b mux failed for %s, keeping .mp3: %v", filepath.Base(outputPath), err)
+		} else {
+			outputPath = m4bPath
+		}
+	}
+
+	return outputPath, nil
+}
+
+// synthesizeChunk sends a single chunk of text to the server over
+// config.Transport (http or grpc) and returns the raw MP3 bytes,
+// retrying on network errors, 5xx/429 (or their grpc equivalents), and
+// context-deadline per config.Retry. The shared limiter and
+// -simulate-failure-rate apply uniformly regardless of transport; each
+// attempt is bounded by config.Timeout.
+//
+// stdout, when set (-stdout, -transport=grpc), is written to exactly once
+// per chunk, only after an attempt has fully succeeded - never mid-stream
+// and never per-attempt - so a retried attempt can't duplicate audio
+// already flushed from an earlier, failed one.
+func synthesizeChunk(ctx context.Context, client *http.Client, grpcConn *grpc.ClientConn, limiter *TokenBucket, config AppConfig, text, voiceDescription string, speed *float64, stdout io.Writer) ([]byte, error) {
+	var jsonData []byte
+	if config.Transport != "grpc" {
+		reqBody := SynthesizeRequest{Text: text, Speed: speed}
+		if voiceDescription != "" {
+			reqBody.VoiceDescription = &voiceDescription
+		}
+
+		var err error
+		jsonData, err = json.Marshal(reqBody)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request: %w", err)
+		}
+	}
+
+	url := fmt.Sprintf("%s/synthesize", config.ServerURL)
+
+	maxAttempts := config.Retry.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+
+		mp3Data, err := attemptSynthesizeTransport(ctx, client, grpcConn, config, url, jsonData, text, voiceDescription, speed)
+		if err == nil {
+			if stdout != nil && config.Transport == "grpc" {
+				if _, werr := stdout.Write(mp3Data); werr != nil {
+					return nil, fmt.Errorf("failed to write audio chunk to stdout: %w", werr)
+				}
+			}
+			return mp3Data, nil
+		}
+
+		lastErr = err
+		if attempt == maxAttempts || !isRetryableError(err) {
+			break
+		}
+
+		if config.Verbose {
+			log.Printf("Retrying /synthesize (attempt %d/%d) after error: %v", attempt+1, maxAttempts, err)
+		}
+
+		select {
+		case <-time.After(config.Retry.backoffDuration(attempt)):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	return nil, lastErr
+}
+
+// attemptSynthesizeTransport makes a single synthesis attempt via
+// config.Transport, bounded by config.Timeout. When
+// config.SimulateFailureRate > 0, it randomly injects a fake transient
+// failure before touching the network, so the retry path is exercisable
+// with either transport.
+func attemptSynthesizeTransport(ctx context.Context, client *http.Client, grpcConn *grpc.ClientConn, config AppConfig, url string, jsonData []byte, text, voiceDescription string, speed *float64) ([]byte, error) {
+	if config.SimulateFailureRate > 0 && rand.Float64() < config.SimulateFailureRate {
+		return nil, &httpStatusError{StatusCode: http.StatusServiceUnavailable, Body: "simulated failure (-simulate-failure-rate)"}
+	}
+
+	attemptCtx := ctx
+	if config.Timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(ctx, config.Timeout)
+		defer cancel()
+	}
+
+	if config.Transport == "grpc" {
+		return synthesizeChunkGRPC(attemptCtx, grpcConn, text, voiceDescription, speed)
+	}
+
+	return attemptSynthesize(attemptCtx, client, url, jsonData)
+}
+
+// attemptSynthesize makes a single /synthesize POST attempt.
+func attemptSynthesize(ctx context.Context, client *http.Client, url string, jsonData []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return nil, &httpStatusError{StatusCode: resp.StatusCode, Body: string(bodyBytes)}
+	}
+
+	mp3Data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return mp3Data, nil
+}
+
+// SynthesizeSample sends a single, un-chunked, un-retried /synthesize
+// request for text and writes the resulting MP3 to outputPath. Used by
+// `mayaspeechify voices test` to produce a quick sample clip without
+// pulling in the full worker-pool/retry/chunking machinery.
+func SynthesizeSample(serverURL string, timeout time.Duration, text, voiceDescription, outputPath string) error {
+	client := &http.Client{Timeout: timeout}
+
+	reqBody := SynthesizeRequest{Text: text}
+	if voiceDescription != "" {
+		reqBody.VoiceDescription = &voiceDescription
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	mp3Data, err := attemptSynthesize(context.Background(), client, serverURL+"/synthesize", jsonData)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(outputPath, mp3Data, 0644); err != nil {
+		return fmt.Errorf("failed to write sample clip: %w", err)
+	}
+
+	return nil
+}
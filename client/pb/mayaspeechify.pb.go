@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: mayaspeechify.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SynthesizeRequest mirrors the HTTP /synthesize request body so the
+// gRPC and HTTP transports accept the same payload shape.
+type SynthesizeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Text             string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	VoiceDescription *string                `protobuf:"bytes,2,opt,name=voice_description,json=voiceDescription,proto3,oneof" json:"voice_description,omitempty"`
+	Speed            *float64               `protobuf:"fixed64,3,opt,name=speed,proto3,oneof" json:"speed,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SynthesizeRequest) Reset() {
+	*x = SynthesizeRequest{}
+	mi := &file_mayaspeechify_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynthesizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynthesizeRequest) ProtoMessage() {}
+
+func (x *SynthesizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mayaspeechify_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynthesizeRequest.ProtoReflect.Descriptor instead.
+func (*SynthesizeRequest) Descriptor() ([]byte, []int) {
+	return file_mayaspeechify_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SynthesizeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SynthesizeRequest) GetVoiceDescription() string {
+	if x != nil && x.VoiceDescription != nil {
+		return *x.VoiceDescription
+	}
+	return ""
+}
+
+func (x *SynthesizeRequest) GetSpeed() float64 {
+	if x != nil && x.Speed != nil {
+		return *x.Speed
+	}
+	return 0
+}
+
+// AudioChunk is one piece of a streamed MP3 response. The server may
+// emit many chunks per request; the client appends them in order.
+type AudioChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	IsFinal       bool                   `protobuf:"varint,2,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AudioChunk) Reset() {
+	*x = AudioChunk{}
+	mi := &file_mayaspeechify_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AudioChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioChunk) ProtoMessage() {}
+
+func (x *AudioChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_mayaspeechify_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioChunk.ProtoReflect.Descriptor instead.
+func (*AudioChunk) Descriptor() ([]byte, []int) {
+	return file_mayaspeechify_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AudioChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *AudioChunk) GetIsFinal() bool {
+	if x != nil {
+		return x.IsFinal
+	}
+	return false
+}
+
+var File_mayaspeechify_proto protoreflect.FileDescriptor
+
+const file_mayaspeechify_proto_rawDesc = "" +
+	"\n" +
+	"\x13mayaspeechify.proto\x12\rmayaspeechify\"\x94\x01\n" +
+	"\x11SynthesizeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x120\n" +
+	"\x11voice_description\x18\x02 \x01(\tH\x00R\x10voiceDescription\x88\x01\x01\x12\x19\n" +
+	"\x05speed\x18\x03 \x01(\x01H\x01R\x05speed\x88\x01\x01B\x14\n" +
+	"\x12_voice_descriptionB\b\n" +
+	"\x06_speed\";\n" +
+	"\n" +
+	"AudioChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\x12\x19\n" +
+	"\bis_final\x18\x02 \x01(\bR\aisFinal2\\\n" +
+	"\rMayaSpeechify\x12K\n" +
+	"\n" +
+	"Synthesize\x12 .mayaspeechify.SynthesizeRequest\x1a\x19.mayaspeechify.AudioChunk0\x01B7Z5github.com/KennethJefferson/MayaSpeechifier/client/pbb\x06proto3"
+
+var (
+	file_mayaspeechify_proto_rawDescOnce sync.Once
+	file_mayaspeechify_proto_rawDescData []byte
+)
+
+func file_mayaspeechify_proto_rawDescGZIP() []byte {
+	file_mayaspeechify_proto_rawDescOnce.Do(func() {
+		file_mayaspeechify_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mayaspeechify_proto_rawDesc), len(file_mayaspeechify_proto_rawDesc)))
+	})
+	return file_mayaspeechify_proto_rawDescData
+}
+
+var file_mayaspeechify_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mayaspeechify_proto_goTypes = []any{
+	(*SynthesizeRequest)(nil), // 0: mayaspeechify.SynthesizeRequest
+	(*AudioChunk)(nil),        // 1: mayaspeechify.AudioChunk
+}
+var file_mayaspeechify_proto_depIdxs = []int32{
+	0, // 0: mayaspeechify.MayaSpeechify.Synthesize:input_type -> mayaspeechify.SynthesizeRequest
+	1, // 1: mayaspeechify.MayaSpeechify.Synthesize:output_type -> mayaspeechify.AudioChunk
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mayaspeechify_proto_init() }
+func file_mayaspeechify_proto_init() {
+	if File_mayaspeechify_proto != nil {
+		return
+	}
+	file_mayaspeechify_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mayaspeechify_proto_rawDesc), len(file_mayaspeechify_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_mayaspeechify_proto_goTypes,
+		DependencyIndexes: file_mayaspeechify_proto_depIdxs,
+		MessageInfos:      file_mayaspeechify_proto_msgTypes,
+	}.Build()
+	File_mayaspeechify_proto = out.File
+	file_mayaspeechify_proto_goTypes = nil
+	file_mayaspeechify_proto_depIdxs = nil
+}
@@ -0,0 +1,130 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: mayaspeechify.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	MayaSpeechify_Synthesize_FullMethodName = "/mayaspeechify.MayaSpeechify/Synthesize"
+)
+
+// MayaSpeechifyClient is the client API for MayaSpeechify service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// MayaSpeechify is the streaming transport alternative to the HTTP
+// /synthesize endpoint, for low-latency incremental playback.
+type MayaSpeechifyClient interface {
+	Synthesize(ctx context.Context, in *SynthesizeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AudioChunk], error)
+}
+
+type mayaSpeechifyClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMayaSpeechifyClient(cc grpc.ClientConnInterface) MayaSpeechifyClient {
+	return &mayaSpeechifyClient{cc}
+}
+
+func (c *mayaSpeechifyClient) Synthesize(ctx context.Context, in *SynthesizeRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AudioChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MayaSpeechify_ServiceDesc.Streams[0], MayaSpeechify_Synthesize_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SynthesizeRequest, AudioChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MayaSpeechify_SynthesizeClient = grpc.ServerStreamingClient[AudioChunk]
+
+// MayaSpeechifyServer is the server API for MayaSpeechify service.
+// All implementations must embed UnimplementedMayaSpeechifyServer
+// for forward compatibility.
+//
+// MayaSpeechify is the streaming transport alternative to the HTTP
+// /synthesize endpoint, for low-latency incremental playback.
+type MayaSpeechifyServer interface {
+	Synthesize(*SynthesizeRequest, grpc.ServerStreamingServer[AudioChunk]) error
+	mustEmbedUnimplementedMayaSpeechifyServer()
+}
+
+// UnimplementedMayaSpeechifyServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMayaSpeechifyServer struct{}
+
+func (UnimplementedMayaSpeechifyServer) Synthesize(*SynthesizeRequest, grpc.ServerStreamingServer[AudioChunk]) error {
+	return status.Error(codes.Unimplemented, "method Synthesize not implemented")
+}
+func (UnimplementedMayaSpeechifyServer) mustEmbedUnimplementedMayaSpeechifyServer() {}
+func (UnimplementedMayaSpeechifyServer) testEmbeddedByValue()                       {}
+
+// UnsafeMayaSpeechifyServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MayaSpeechifyServer will
+// result in compilation errors.
+type UnsafeMayaSpeechifyServer interface {
+	mustEmbedUnimplementedMayaSpeechifyServer()
+}
+
+func RegisterMayaSpeechifyServer(s grpc.ServiceRegistrar, srv MayaSpeechifyServer) {
+	// If the following call panics, it indicates UnimplementedMayaSpeechifyServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MayaSpeechify_ServiceDesc, srv)
+}
+
+func _MayaSpeechify_Synthesize_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SynthesizeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MayaSpeechifyServer).Synthesize(m, &grpc.GenericServerStream[SynthesizeRequest, AudioChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MayaSpeechify_SynthesizeServer = grpc.ServerStreamingServer[AudioChunk]
+
+// MayaSpeechify_ServiceDesc is the grpc.ServiceDesc for MayaSpeechify service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MayaSpeechify_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "mayaspeechify.MayaSpeechify",
+	HandlerType: (*MayaSpeechifyServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Synthesize",
+			Handler:       _MayaSpeechify_Synthesize_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "mayaspeechify.proto",
+}